@@ -0,0 +1,17 @@
+// Package bench provides the statistical aggregation and reporting
+// behind godog's benchmark mode: a Recorder step definitions can
+// record user-defined values against, Compute to turn a series of
+// samples into min/max/mean/median/stddev/p95, and WriteText/WriteJSON
+// to emit the aggregated Report.
+//
+// Benchmark mode itself - running a BenchmarkTag-ed ("@benchmark")
+// scenario samples times and aggregating its wall-clock durations into
+// a Report - is wired into the suite's Run/runFeature via
+// suite.Benchmark(samples) and suite.BenchReport(), since this
+// codebase has no Options type to carry an Options.Benchmark field and
+// no formatter registry to register a "benchmark" formatter under.
+// Those two gaps remain: there is no per-step timing (only the
+// scenario's overall wall-clock is measured) and no way for a step
+// definition to reach a Recorder, since StepHandler.HandleStep has no
+// context parameter to thread one through.
+package bench
@@ -0,0 +1,47 @@
+package bench
+
+import "sync"
+
+// Recorder collects user-defined measurements by name. Benchmark mode
+// does not create a Recorder or hand one to step definitions: the
+// suite only measures each sample's overall wall-clock time, with no
+// per-step timings.
+type Recorder struct {
+	mu     sync.Mutex
+	values map[string][]float64
+}
+
+// NewRecorder returns an empty Recorder ready to have values recorded
+// against it.
+func NewRecorder() *Recorder {
+	return &Recorder{values: map[string][]float64{}}
+}
+
+// Record appends value under name, so a single sample run may record
+// the same named measurement more than once.
+func (r *Recorder) Record(name string, value float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[name] = append(r.values[name], value)
+}
+
+// Values returns the recorded values for name, or nil if nothing was
+// recorded under that name.
+func (r *Recorder) Values(name string) []float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]float64(nil), r.values[name]...)
+}
+
+// Names returns every name that had at least one value recorded
+// against it.
+func (r *Recorder) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.values))
+	for name := range r.values {
+		names = append(names, name)
+	}
+	return names
+}
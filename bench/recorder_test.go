@@ -0,0 +1,69 @@
+package bench
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestRecorderRecordAndValues(t *testing.T) {
+	r := NewRecorder()
+
+	r.Record("latency", 1.5)
+	r.Record("latency", 2.5)
+	r.Record("throughput", 100)
+
+	if got := r.Values("latency"); !reflect.DeepEqual(got, []float64{1.5, 2.5}) {
+		t.Fatalf("expected recorded latency values, got %v", got)
+	}
+	if got := r.Values("throughput"); !reflect.DeepEqual(got, []float64{100}) {
+		t.Fatalf("expected recorded throughput values, got %v", got)
+	}
+	if got := r.Values("missing"); got != nil {
+		t.Fatalf("expected nil for a name nothing was recorded under, got %v", got)
+	}
+}
+
+func TestRecorderNames(t *testing.T) {
+	r := NewRecorder()
+	r.Record("a", 1)
+	r.Record("b", 2)
+
+	names := r.Names()
+	sort.Strings(names)
+
+	if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Fatalf("expected names [a b], got %v", names)
+	}
+}
+
+func TestRecorderValuesIsACopy(t *testing.T) {
+	r := NewRecorder()
+	r.Record("a", 1)
+
+	values := r.Values("a")
+	values[0] = 999
+
+	if got := r.Values("a"); got[0] != 1 {
+		t.Fatalf("expected mutating the returned slice not to affect the recorder, got %v", got)
+	}
+}
+
+func TestRecorderIsSafeForConcurrentUse(t *testing.T) {
+	r := NewRecorder()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(v float64) {
+			defer wg.Done()
+			r.Record("concurrent", v)
+		}(float64(i))
+	}
+	wg.Wait()
+
+	if got := len(r.Values("concurrent")); got != 50 {
+		t.Fatalf("expected 50 recorded values, got %d", got)
+	}
+}
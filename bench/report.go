@@ -0,0 +1,62 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Measurement is one named series of samples gathered for a
+// benchmarked scenario. Benchmark mode produces a single "wall-clock"
+// Measurement per scenario; it records no per-step timings.
+type Measurement struct {
+	Name  string `json:"name"`
+	Unit  string `json:"unit"`
+	Stats Stats  `json:"stats"`
+}
+
+// ScenarioReport aggregates every Measurement gathered across the N
+// samples a benchmarked scenario was run for.
+type ScenarioReport struct {
+	Name         string        `json:"name"`
+	Samples      int           `json:"samples"`
+	Failed       int           `json:"failed"`
+	Measurements []Measurement `json:"measurements"`
+}
+
+// Report is the top level document produced by the benchmark
+// formatter, covering every benchmarked scenario in a run.
+type Report struct {
+	Scenarios []ScenarioReport `json:"scenarios"`
+}
+
+// WriteText renders report as human-readable plain text, one section
+// per scenario and one line per measurement.
+func WriteText(w io.Writer, report Report) {
+	for _, sc := range report.Scenarios {
+		fmt.Fprintf(w, "%s (%d samples, %d failed)\n", sc.Name, sc.Samples, sc.Failed)
+		for _, m := range sc.Measurements {
+			fmt.Fprintf(w, "  %-20s min=%.4f%s max=%.4f%s mean=%.4f%s median=%.4f%s stddev=%.4f%s p95=%.4f%s\n",
+				m.Name,
+				m.Stats.Min, m.Unit,
+				m.Stats.Max, m.Unit,
+				m.Stats.Mean, m.Unit,
+				m.Stats.Median, m.Unit,
+				m.Stats.StdDev, m.Unit,
+				m.Stats.P95, m.Unit,
+			)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// WriteJSON renders report as indented JSON, suitable for archiving a
+// run's results or feeding them into custom tooling. Note that this is
+// not benchstat's input format: benchstat parses Go's `testing.B`
+// text benchmark output, not arbitrary JSON, so a Report needs its own
+// consumer or a translation step before benchstat can read it.
+func WriteJSON(w io.Writer, report Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(report)
+}
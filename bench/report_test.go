@@ -0,0 +1,58 @@
+package bench
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func sampleReport() Report {
+	return Report{
+		Scenarios: []ScenarioReport{
+			{
+				Name:    "logs in",
+				Samples: 3,
+				Failed:  1,
+				Measurements: []Measurement{
+					{Name: "wall-clock", Unit: "s", Stats: Compute([]float64{1, 2, 3})},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteTextIncludesScenarioAndMeasurement(t *testing.T) {
+	var buf bytes.Buffer
+	WriteText(&buf, sampleReport())
+
+	out := buf.String()
+	if !strings.Contains(out, "logs in") {
+		t.Fatalf("expected scenario name in text report, got %q", out)
+	}
+	if !strings.Contains(out, "3 samples, 1 failed") {
+		t.Fatalf("expected sample/failure counts in text report, got %q", out)
+	}
+	if !strings.Contains(out, "wall-clock") {
+		t.Fatalf("expected measurement name in text report, got %q", out)
+	}
+}
+
+func TestWriteJSONRoundTrips(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, sampleReport()); err != nil {
+		t.Fatalf("WriteJSON: %s", err)
+	}
+
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("unmarshal: %s", err)
+	}
+
+	if len(decoded.Scenarios) != 1 || decoded.Scenarios[0].Name != "logs in" {
+		t.Fatalf("expected decoded report to round-trip the scenario, got %+v", decoded)
+	}
+	if decoded.Scenarios[0].Measurements[0].Stats.Mean != 2 {
+		t.Fatalf("expected decoded mean 2, got %v", decoded.Scenarios[0].Measurements[0].Stats.Mean)
+	}
+}
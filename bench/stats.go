@@ -0,0 +1,70 @@
+package bench
+
+import (
+	"math"
+	"sort"
+)
+
+// Stats holds the statistical aggregation of a series of samples,
+// such as the per-scenario wall-clock times gathered across all runs
+// of a benchmarked scenario.
+type Stats struct {
+	Samples int     `json:"samples"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+	Mean    float64 `json:"mean"`
+	Median  float64 `json:"median"`
+	StdDev  float64 `json:"stddev"`
+	P95     float64 `json:"p95"`
+}
+
+// Compute aggregates values into a Stats. It does not mutate values.
+func Compute(values []float64) Stats {
+	if len(values) == 0 {
+		return Stats{}
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	var sum float64
+	for _, v := range sorted {
+		sum += v
+	}
+	mean := sum / float64(len(sorted))
+
+	var variance float64
+	for _, v := range sorted {
+		d := v - mean
+		variance += d * d
+	}
+	variance /= float64(len(sorted))
+
+	return Stats{
+		Samples: len(sorted),
+		Min:     sorted[0],
+		Max:     sorted[len(sorted)-1],
+		Mean:    mean,
+		Median:  percentile(sorted, 0.5),
+		StdDev:  math.Sqrt(variance),
+		P95:     percentile(sorted, 0.95),
+	}
+}
+
+// percentile returns the p-th percentile (0..1) of pre-sorted values
+// using linear interpolation between closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+
+	rank := p * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return sorted[lo]
+	}
+
+	frac := rank - float64(lo)
+	return sorted[lo] + frac*(sorted[hi]-sorted[lo])
+}
@@ -0,0 +1,87 @@
+package bench
+
+import "testing"
+
+func TestComputeEmpty(t *testing.T) {
+	stats := Compute(nil)
+	if stats.Samples != 0 {
+		t.Fatalf("expected zero-value Stats for no samples, got %+v", stats)
+	}
+}
+
+func TestComputeSingleSample(t *testing.T) {
+	stats := Compute([]float64{42})
+
+	if stats.Samples != 1 {
+		t.Fatalf("expected 1 sample, got %d", stats.Samples)
+	}
+	for name, got := range map[string]float64{
+		"min":    stats.Min,
+		"max":    stats.Max,
+		"mean":   stats.Mean,
+		"median": stats.Median,
+		"p95":    stats.P95,
+	} {
+		if got != 42 {
+			t.Errorf("expected %s to be 42 for a single sample, got %v", name, got)
+		}
+	}
+	if stats.StdDev != 0 {
+		t.Errorf("expected stddev 0 for a single sample, got %v", stats.StdDev)
+	}
+}
+
+func TestComputeAllEqualValues(t *testing.T) {
+	stats := Compute([]float64{5, 5, 5, 5, 5})
+
+	if stats.Min != 5 || stats.Max != 5 || stats.Mean != 5 || stats.Median != 5 || stats.P95 != 5 {
+		t.Fatalf("expected every statistic to equal the constant sample value, got %+v", stats)
+	}
+	if stats.StdDev != 0 {
+		t.Fatalf("expected stddev 0 for identical samples, got %v", stats.StdDev)
+	}
+}
+
+func TestComputeKnownDistribution(t *testing.T) {
+	stats := Compute([]float64{1, 2, 3, 4, 5})
+
+	if stats.Min != 1 {
+		t.Errorf("expected min 1, got %v", stats.Min)
+	}
+	if stats.Max != 5 {
+		t.Errorf("expected max 5, got %v", stats.Max)
+	}
+	if stats.Mean != 3 {
+		t.Errorf("expected mean 3, got %v", stats.Mean)
+	}
+	if stats.Median != 3 {
+		t.Errorf("expected median 3, got %v", stats.Median)
+	}
+}
+
+func TestComputeDoesNotMutateInput(t *testing.T) {
+	values := []float64{5, 3, 1, 4, 2}
+	original := append([]float64(nil), values...)
+
+	Compute(values)
+
+	for i := range values {
+		if values[i] != original[i] {
+			t.Fatalf("expected Compute not to reorder its input, got %v, want %v", values, original)
+		}
+	}
+}
+
+func TestPercentileInterpolation(t *testing.T) {
+	sorted := []float64{10, 20, 30, 40}
+
+	if got := percentile(sorted, 0); got != 10 {
+		t.Errorf("expected p0 to be the minimum, got %v", got)
+	}
+	if got := percentile(sorted, 1); got != 40 {
+		t.Errorf("expected p100 to be the maximum, got %v", got)
+	}
+	if got := percentile(sorted, 0.5); got != 25 {
+		t.Errorf("expected p50 to interpolate to 25, got %v", got)
+	}
+}
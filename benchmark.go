@@ -0,0 +1,83 @@
+package godog
+
+import (
+	"time"
+
+	"github.com/DATA-DOG/godog/bench"
+	"github.com/DATA-DOG/godog/gherkin"
+)
+
+// BenchmarkTag marks a scenario to be run repeatedly under Benchmark
+// mode instead of once, with per-run wall-clock timings aggregated
+// into a bench.Report.
+const BenchmarkTag = "@benchmark"
+
+// IsBenchmarked reports whether tags carries BenchmarkTag.
+func IsBenchmarked(tags []string) bool {
+	for _, tag := range tags {
+		if tag == BenchmarkTag {
+			return true
+		}
+	}
+	return false
+}
+
+// Benchmark puts the suite into benchmark mode: every scenario tagged
+// BenchmarkTag runs samples times instead of once, with its
+// background re-run before each sample (standing in for the
+// BeforeScenario hook this codebase does not have), and the
+// per-sample wall-clock durations aggregated via bench.Compute into
+// the Report returned by BenchReport. samples <= 0 disables benchmark
+// mode, which is also the default.
+func (s *suite) Benchmark(samples int) {
+	s.benchmarkSamples = samples
+}
+
+// BenchReport returns the aggregated benchmark results gathered by the
+// most recent Run, one bench.ScenarioReport per BenchmarkTag-ed
+// scenario that ran.
+func (s *suite) BenchReport() bench.Report {
+	return s.benchReport
+}
+
+// runBenchmarkedScenario runs scenario's background and steps
+// s.benchmarkSamples times, recording each sample's wall-clock
+// duration, and appends the aggregated result to s.benchReport. It
+// returns whether any sample failed.
+func (s *suite) runBenchmarkedScenario(f *gherkin.Feature, scenario *gherkin.Scenario) bool {
+	report := bench.ScenarioReport{Name: scenario.Name, Samples: s.benchmarkSamples}
+	wallClock := make([]float64, 0, s.benchmarkSamples)
+	var anyFailed bool
+
+	for i := 0; i < s.benchmarkSamples; i++ {
+		start := time.Now()
+
+		var failed bool
+		if f.Background != nil {
+			s.fmt.Node(f.Background)
+			failed = s.runSteps(f.Background.Steps)
+		}
+
+		s.fmt.Node(scenario)
+		if failed {
+			s.skipSteps(scenario.Steps)
+		} else {
+			failed = s.runSteps(scenario.Steps)
+		}
+
+		wallClock = append(wallClock, time.Since(start).Seconds())
+		if failed {
+			anyFailed = true
+			report.Failed++
+		}
+	}
+
+	report.Measurements = append(report.Measurements, bench.Measurement{
+		Name:  "wall-clock",
+		Unit:  "s",
+		Stats: bench.Compute(wallClock),
+	})
+	s.benchReport.Scenarios = append(s.benchReport.Scenarios, report)
+
+	return anyFailed
+}
@@ -0,0 +1,73 @@
+package godog
+
+import "github.com/DATA-DOG/godog/gherkin"
+
+// FocusTag and PendingTag are the tags that enable ginkgo-style
+// focused/pending scenario semantics: tagging any scenario with
+// FocusTag puts the whole run into focus mode (only focused
+// scenarios execute, everything else is reported skipped), while
+// PendingTag always marks a scenario pending regardless of whether a
+// step definition would otherwise match its steps.
+const (
+	FocusTag   = "@focus"
+	PendingTag = "@pending"
+)
+
+// HasFocusedScenarios reports whether any tag set in scenarioTags
+// carries FocusTag, putting the run into focus mode.
+func HasFocusedScenarios(scenarioTags [][]string) bool {
+	for _, tags := range scenarioTags {
+		if IsFocused(tags) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFocused reports whether tags carries FocusTag.
+func IsFocused(tags []string) bool {
+	for _, tag := range tags {
+		if tag == FocusTag {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPending reports whether tags carries PendingTag.
+func IsPending(tags []string) bool {
+	for _, tag := range tags {
+		if tag == PendingTag {
+			return true
+		}
+	}
+	return false
+}
+
+// FocusModeFailsRun reports whether a run should be forced to fail,
+// like ginkgo's --fail-on-focused, because it executed under focus
+// mode. This lets CI catch a @focus tag that was accidentally left
+// committed.
+func FocusModeFailsRun(focusMode, failOnFocused bool) bool {
+	return focusMode && failOnFocused
+}
+
+// PendingModeFailsRun reports whether a run should be forced to fail
+// because it encountered a pending step or scenario - either an
+// unmatched step or one tagged PendingTag - while failOnPending is
+// enabled. Without failOnPending, a pending step is reported but does
+// not fail the overall run, the same strict/non-strict distinction
+// cucumber's --strict flag makes.
+func PendingModeFailsRun(pendingEncountered, failOnPending bool) bool {
+	return pendingEncountered && failOnPending
+}
+
+// tagNames converts a gherkin tag list to the plain []string the
+// helpers above operate on.
+func tagNames(tags []*gherkin.Tag) []string {
+	names := make([]string, len(tags))
+	for i, tag := range tags {
+		names[i] = tag.Name
+	}
+	return names
+}
@@ -0,0 +1,97 @@
+package godog
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/godog/gherkin"
+)
+
+func TestHasFocusedScenarios(t *testing.T) {
+	cases := []struct {
+		name     string
+		tagSets  [][]string
+		expected bool
+	}{
+		{"none focused", [][]string{{"@wip"}, {"@slow"}}, false},
+		{"one focused", [][]string{{"@wip"}, {"@focus", "@slow"}}, true},
+		{"no tags", [][]string{{}, nil}, false},
+	}
+
+	for _, c := range cases {
+		if actual := HasFocusedScenarios(c.tagSets); actual != c.expected {
+			t.Errorf("%s: expected %v, got %v", c.name, c.expected, actual)
+		}
+	}
+}
+
+func TestIsFocusedAndIsPending(t *testing.T) {
+	if IsFocused([]string{"@wip"}) {
+		t.Error("expected @wip not to be focused")
+	}
+	if !IsFocused([]string{"@wip", "@focus"}) {
+		t.Error("expected @focus to be focused")
+	}
+	if IsPending([]string{"@focus"}) {
+		t.Error("expected @focus not to be pending")
+	}
+	if !IsPending([]string{"@pending"}) {
+		t.Error("expected @pending to be pending")
+	}
+}
+
+func TestFocusModeFailsRun(t *testing.T) {
+	if FocusModeFailsRun(false, true) {
+		t.Error("expected no failure when not in focus mode")
+	}
+	if FocusModeFailsRun(true, false) {
+		t.Error("expected no failure when FailOnFocused is disabled")
+	}
+	if !FocusModeFailsRun(true, true) {
+		t.Error("expected failure when run executed under focus mode with FailOnFocused enabled")
+	}
+}
+
+func TestPendingModeFailsRun(t *testing.T) {
+	if PendingModeFailsRun(false, true) {
+		t.Error("expected no failure when no step was pending")
+	}
+	if PendingModeFailsRun(true, false) {
+		t.Error("expected no failure when FailOnPending is disabled")
+	}
+	if !PendingModeFailsRun(true, true) {
+		t.Error("expected failure when a pending step was encountered with FailOnPending enabled")
+	}
+}
+
+func TestTagNames(t *testing.T) {
+	tags := []*gherkin.Tag{{Name: "@focus"}, {Name: "@slow"}}
+
+	actual := tagNames(tags)
+	expected := []string{"@focus", "@slow"}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, actual)
+		}
+	}
+}
+
+func TestScenarioTagsInheritsFeatureTags(t *testing.T) {
+	f := &gherkin.Feature{Tags: []*gherkin.Tag{{Name: "@api"}}}
+	scenario := &gherkin.Scenario{Tags: []*gherkin.Tag{{Name: "@focus"}}}
+
+	actual := scenarioTags(f, scenario)
+	expected := []string{"@api", "@focus"}
+
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, actual)
+		}
+	}
+}
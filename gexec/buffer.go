@@ -0,0 +1,62 @@
+package gexec
+
+import (
+	"bytes"
+	"regexp"
+	"sync"
+)
+
+// Buffer is a growable, concurrency-safe byte buffer that a running
+// process can be written to while step definitions read from it.
+//
+// It keeps track of how much of its contents has already been
+// searched by Say so that repeated matching does not rescan output
+// that was already consumed.
+type Buffer struct {
+	mu       sync.Mutex
+	contents bytes.Buffer
+	searched int
+}
+
+func newBuffer() *Buffer {
+	return &Buffer{}
+}
+
+// Write appends p to the Buffer. It implements io.Writer so a Buffer
+// can be handed directly to exec.Cmd.Stdout / Stderr.
+func (b *Buffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.contents.Write(p)
+}
+
+// Contents returns a copy of everything written to the Buffer so far.
+func (b *Buffer) Contents() []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	out := make([]byte, b.contents.Len())
+	copy(out, b.contents.Bytes())
+	return out
+}
+
+// Say reports whether pattern matches any unsearched portion of the
+// Buffer's contents. A successful match advances the search cursor
+// past the match so subsequent calls only look at new output.
+func (b *Buffer) Say(pattern string) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	unsearched := b.contents.Bytes()[b.searched:]
+	loc := re.FindIndex(unsearched)
+	if loc == nil {
+		return false, nil
+	}
+
+	b.searched += loc[1]
+	return true, nil
+}
@@ -0,0 +1,80 @@
+package gexec
+
+import "testing"
+
+func TestBufferSayAdvancesCursorPastMatch(t *testing.T) {
+	b := newBuffer()
+	b.Write([]byte("listening on :8080\n"))
+
+	ok, err := b.Say("listening on :8080")
+	if err != nil {
+		t.Fatalf("Say: %s", err)
+	}
+	if !ok {
+		t.Fatal("expected Say to match already-written content")
+	}
+
+	// The match is fully consumed: searching for the same pattern
+	// again must not find it a second time.
+	ok, err = b.Say("listening on :8080")
+	if err != nil {
+		t.Fatalf("Say: %s", err)
+	}
+	if ok {
+		t.Fatal("expected Say not to rematch already-searched content")
+	}
+}
+
+func TestBufferSayOnlyMatchesNewOutput(t *testing.T) {
+	b := newBuffer()
+	b.Write([]byte("first line\n"))
+
+	if ok, _ := b.Say("first line"); !ok {
+		t.Fatal("expected first Say to match")
+	}
+
+	b.Write([]byte("second line\n"))
+
+	ok, err := b.Say("second line")
+	if err != nil {
+		t.Fatalf("Say: %s", err)
+	}
+	if !ok {
+		t.Fatal("expected Say to match content written after the cursor")
+	}
+
+	// first line is behind the cursor now, so it shouldn't match again.
+	if ok, _ := b.Say("first line"); ok {
+		t.Fatal("expected Say not to rematch content before the cursor")
+	}
+}
+
+func TestBufferSayNoMatch(t *testing.T) {
+	b := newBuffer()
+	b.Write([]byte("hello\n"))
+
+	ok, err := b.Say("goodbye")
+	if err != nil {
+		t.Fatalf("Say: %s", err)
+	}
+	if ok {
+		t.Fatal("expected no match for a pattern not present in the buffer")
+	}
+}
+
+func TestBufferSayInvalidPattern(t *testing.T) {
+	b := newBuffer()
+	if _, err := b.Say("("); err == nil {
+		t.Fatal("expected an error for an invalid regexp pattern")
+	}
+}
+
+func TestBufferContents(t *testing.T) {
+	b := newBuffer()
+	b.Write([]byte("abc"))
+	b.Write([]byte("def"))
+
+	if got := string(b.Contents()); got != "abcdef" {
+		t.Fatalf("expected accumulated contents %q, got %q", "abcdef", got)
+	}
+}
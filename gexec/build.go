@@ -0,0 +1,100 @@
+package gexec
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+)
+
+var (
+	artifactsMu  sync.Mutex
+	artifactsDir string
+	buildCount   int
+)
+
+// Build go-builds the package at pkgPath into a temporary binary and
+// returns the path to the resulting executable. All binaries produced
+// by Build share a single temp directory that is removed in one shot
+// by CleanupBuildArtifacts; this codebase does not yet expose an
+// AfterSuite hook, so callers are responsible for calling
+// CleanupBuildArtifacts themselves once their run is done (that is
+// what an AfterSuite hook would call, once one exists).
+func Build(pkgPath string) (string, error) {
+	dir, err := artifactsRoot()
+	if err != nil {
+		return "", err
+	}
+
+	// Each call gets its own numbered subdirectory so two packages
+	// that happen to share a base name (e.g. .../a/app and
+	// .../b/app) don't silently clobber each other's binary.
+	artifactsMu.Lock()
+	buildCount++
+	buildDir := filepath.Join(dir, fmt.Sprintf("%d", buildCount))
+	artifactsMu.Unlock()
+
+	if err := os.MkdirAll(buildDir, 0700); err != nil {
+		return "", err
+	}
+
+	// Name the binary after the absolute path's last element, so a
+	// relative pkgPath such as "." still yields a file name rather
+	// than the build directory itself.
+	name := filepath.Base(pkgPath)
+	if abs, err := filepath.Abs(pkgPath); err == nil {
+		name = filepath.Base(abs)
+	}
+	binPath := filepath.Join(buildDir, name+binSuffix())
+
+	cmd := exec.Command("go", "build", "-o", binPath, pkgPath)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("gexec: failed to build %s: %s\n%s", pkgPath, err, out)
+	}
+
+	return binPath, nil
+}
+
+// CleanupBuildArtifacts removes all binaries produced by Build so far
+// and resets the package for the next Build call. Calling it when no
+// binaries were built is a no-op.
+func CleanupBuildArtifacts() {
+	artifactsMu.Lock()
+	defer artifactsMu.Unlock()
+
+	if artifactsDir == "" {
+		return
+	}
+
+	os.RemoveAll(artifactsDir)
+	artifactsDir = ""
+	buildCount = 0
+}
+
+func artifactsRoot() (string, error) {
+	artifactsMu.Lock()
+	defer artifactsMu.Unlock()
+
+	if artifactsDir != "" {
+		return artifactsDir, nil
+	}
+
+	dir, err := ioutil.TempDir("", "godog-gexec")
+	if err != nil {
+		return "", err
+	}
+
+	artifactsDir = dir
+	return dir, nil
+}
+
+func binSuffix() string {
+	if runtime.GOOS == "windows" {
+		return ".exe"
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package gexec
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const mainGoSource = `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("built ok")
+}
+`
+
+func writeBuildablePackage(t *testing.T) string {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "gexec-build-fixture")
+	if err != nil {
+		t.Fatalf("TempDir: %s", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "go.mod"), []byte("module fixture\n\ngo 1.16\n"), 0600); err != nil {
+		t.Fatalf("write go.mod: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte(mainGoSource), 0600); err != nil {
+		t.Fatalf("write main.go: %s", err)
+	}
+
+	return dir
+}
+
+// withWorkingDir temporarily chdirs into dir, restoring the original
+// working directory afterwards, since Build runs `go build` relative
+// to the process's current directory.
+func withWorkingDir(t *testing.T, dir string) {
+	t.Helper()
+
+	original, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("Getwd: %s", err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Chdir: %s", err)
+	}
+	t.Cleanup(func() { os.Chdir(original) })
+}
+
+func TestBuildProducesARunnableBinary(t *testing.T) {
+	defer CleanupBuildArtifacts()
+
+	withWorkingDir(t, writeBuildablePackage(t))
+
+	binPath, err := Build(".")
+	if err != nil {
+		t.Fatalf("Build: %s", err)
+	}
+
+	if _, err := os.Stat(binPath); err != nil {
+		t.Fatalf("expected built binary to exist at %s: %s", binPath, err)
+	}
+}
+
+func TestBuildDoesNotClobberSameBaseNameBinaries(t *testing.T) {
+	defer CleanupBuildArtifacts()
+
+	withWorkingDir(t, writeBuildablePackage(t))
+
+	first, err := Build(".")
+	if err != nil {
+		t.Fatalf("first Build: %s", err)
+	}
+	second, err := Build(".")
+	if err != nil {
+		t.Fatalf("second Build: %s", err)
+	}
+
+	if first == second {
+		t.Fatalf("expected two Build calls to produce distinct paths, both got %s", first)
+	}
+
+	if _, err := os.Stat(first); err != nil {
+		t.Fatalf("expected first binary to still exist after the second Build: %s", err)
+	}
+	if _, err := os.Stat(second); err != nil {
+		t.Fatalf("expected second binary to exist: %s", err)
+	}
+}
+
+func TestCleanupBuildArtifactsRemovesBuiltBinaries(t *testing.T) {
+	withWorkingDir(t, writeBuildablePackage(t))
+
+	binPath, err := Build(".")
+	if err != nil {
+		t.Fatalf("Build: %s", err)
+	}
+
+	CleanupBuildArtifacts()
+
+	if _, err := os.Stat(binPath); !os.IsNotExist(err) {
+		t.Fatalf("expected binary to be removed after CleanupBuildArtifacts, stat err: %v", err)
+	}
+}
@@ -0,0 +1,13 @@
+// Package gexec offers support for testing external processes from
+// within godog step definitions, inspired by gomega's gexec package.
+//
+// Build compiles a Go package to a temporary binary, and Start wraps
+// an *exec.Cmd in a Session that captures stdout/stderr and exposes
+// assertions such as Say, Wait and ExitCode - enough to express
+// CLI-behavior features like:
+//
+//	When I run "myapp --flag"
+//	Then the output should say "done"
+//
+// without every project reinventing its own Cmd wrapper.
+package gexec
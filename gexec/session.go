@@ -0,0 +1,112 @@
+package gexec
+
+import (
+	"errors"
+	"os/exec"
+	"time"
+)
+
+// ErrSessionStillRunning is returned by Wait when its timeout elapses
+// before the underlying process has exited.
+var ErrSessionStillRunning = errors.New("gexec: session is still running")
+
+// Session wraps a running *exec.Cmd, capturing its stdout and stderr
+// into growable buffers so step definitions can make assertions
+// against the output of a command started during a scenario.
+type Session struct {
+	cmd      *exec.Cmd
+	out      *Buffer
+	err      *Buffer
+	exited   chan struct{}
+	exitCode int
+}
+
+// Start launches cmd, wiring its Stdout and Stderr to in-memory
+// buffers unless they were already set by the caller, and returns a
+// Session that step definitions can assert against.
+func Start(cmd *exec.Cmd) (*Session, error) {
+	s := &Session{
+		cmd:      cmd,
+		out:      newBuffer(),
+		err:      newBuffer(),
+		exited:   make(chan struct{}),
+		exitCode: -1,
+	}
+
+	if cmd.Stdout == nil {
+		cmd.Stdout = s.out
+	}
+	if cmd.Stderr == nil {
+		cmd.Stderr = s.err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	go func() {
+		_ = cmd.Wait()
+		if cmd.ProcessState != nil {
+			s.exitCode = cmd.ProcessState.ExitCode()
+		}
+		close(s.exited)
+	}()
+
+	return s, nil
+}
+
+// Out exposes the captured stdout. Step definitions typically call
+// Say on it, e.g. session.Out().Say("listening on :8080").
+func (s *Session) Out() *Buffer {
+	return s.out
+}
+
+// Err exposes the captured stderr.
+func (s *Session) Err() *Buffer {
+	return s.err
+}
+
+// Say matches pattern against the unsearched portion of stdout. It is
+// a convenience shorthand for session.Out().Say(pattern), mirroring
+// the step-friendly signature step definitions expect.
+func (s *Session) Say(pattern string) (bool, error) {
+	return s.out.Say(pattern)
+}
+
+// Wait blocks until the process exits or timeout elapses, whichever
+// comes first. A timeout <= 0 means wait indefinitely.
+func (s *Session) Wait(timeout time.Duration) error {
+	if timeout <= 0 {
+		<-s.exited
+		return nil
+	}
+
+	select {
+	case <-s.exited:
+		return nil
+	case <-time.After(timeout):
+		return ErrSessionStillRunning
+	}
+}
+
+// Kill sends os.Kill to the process. It is a no-op if the process has
+// already exited.
+func (s *Session) Kill() error {
+	select {
+	case <-s.exited:
+		return nil
+	default:
+		return s.cmd.Process.Kill()
+	}
+}
+
+// ExitCode returns the process exit code, or -1 if it has not exited
+// yet.
+func (s *Session) ExitCode() int {
+	select {
+	case <-s.exited:
+		return s.exitCode
+	default:
+		return -1
+	}
+}
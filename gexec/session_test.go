@@ -0,0 +1,87 @@
+package gexec
+
+import (
+	"os/exec"
+	"testing"
+	"time"
+)
+
+func TestSessionCapturesOutputAndExitCode(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "echo hello; exit 3")
+
+	session, err := Start(cmd)
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+
+	if err := session.Wait(5 * time.Second); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+
+	if ok, err := session.Say("hello"); err != nil || !ok {
+		t.Fatalf("expected Say(\"hello\") to match, ok=%v err=%v", ok, err)
+	}
+
+	if got := session.ExitCode(); got != 3 {
+		t.Fatalf("expected exit code 3, got %d", got)
+	}
+}
+
+func TestSessionWaitTimesOutWhileStillRunning(t *testing.T) {
+	cmd := exec.Command("sleep", "1")
+
+	session, err := Start(cmd)
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+	defer session.Kill()
+
+	if err := session.Wait(10 * time.Millisecond); err != ErrSessionStillRunning {
+		t.Fatalf("expected ErrSessionStillRunning, got %v", err)
+	}
+
+	if got := session.ExitCode(); got != -1 {
+		t.Fatalf("expected exit code -1 while still running, got %d", got)
+	}
+}
+
+func TestSessionKillStopsTheProcess(t *testing.T) {
+	// A bare "sleep" (no shell wrapper) so killing the process we
+	// started actually terminates the thing holding the stdout pipe
+	// open, instead of a forked grandchild that would keep it open.
+	cmd := exec.Command("sleep", "30")
+
+	session, err := Start(cmd)
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+
+	if err := session.Kill(); err != nil {
+		t.Fatalf("Kill: %s", err)
+	}
+
+	if err := session.Wait(5 * time.Second); err != nil {
+		t.Fatalf("Wait after Kill: %s", err)
+	}
+
+	if got := session.ExitCode(); got == 0 {
+		t.Fatalf("expected a non-zero exit code for a killed process, got %d", got)
+	}
+}
+
+func TestSessionKillAfterExitIsANoop(t *testing.T) {
+	cmd := exec.Command("sh", "-c", "exit 0")
+
+	session, err := Start(cmd)
+	if err != nil {
+		t.Fatalf("Start: %s", err)
+	}
+
+	if err := session.Wait(5 * time.Second); err != nil {
+		t.Fatalf("Wait: %s", err)
+	}
+
+	if err := session.Kill(); err != nil {
+		t.Fatalf("expected Kill on an already-exited process to be a no-op, got %s", err)
+	}
+}
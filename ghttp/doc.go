@@ -0,0 +1,14 @@
+// Package ghttp lets step definitions stand up an in-process HTTP
+// server and register ordered expectations against it, inspired by
+// gomega's ghttp package.
+//
+// A Server is created with NewServer, handlers are queued with
+// AppendHandlers or bound to a fixed route with RouteToHandler, and
+// CombineHandlers composes several handlers (request verification
+// plus a response) into one. Close should be called once the
+// scenario ends to assert that every appended handler was actually
+// consumed. There is no AfterScenario hook that calls it, and it does
+// not report through the suite's Formatter, so callers are
+// responsible for calling it and handling the returned error
+// themselves.
+package ghttp
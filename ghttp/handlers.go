@@ -0,0 +1,113 @@
+package ghttp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+)
+
+// VerifyRequest returns a handler that records a failure on the
+// server if the incoming request's method and path do not match
+// method and path.
+func (s *Server) VerifyRequest(method, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method || r.URL.Path != path {
+			s.fail(fmt.Errorf("ghttp: expected request %s %s, got %s %s", method, path, r.Method, r.URL.Path))
+		}
+	}
+}
+
+// VerifyJSON returns a handler that records a failure on the server
+// if the request body is not JSON equal to body.
+func (s *Server) VerifyJSON(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		actual, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			s.fail(fmt.Errorf("ghttp: failed to read request body: %s", err))
+			return
+		}
+
+		var expectedValue, actualValue interface{}
+		if err := json.Unmarshal([]byte(body), &expectedValue); err != nil {
+			s.fail(fmt.Errorf("ghttp: failed to unmarshal expected JSON: %s", err))
+			return
+		}
+		if err := json.Unmarshal(actual, &actualValue); err != nil {
+			s.fail(fmt.Errorf("ghttp: failed to unmarshal request body as JSON: %s", err))
+			return
+		}
+
+		if !reflect.DeepEqual(expectedValue, actualValue) {
+			s.fail(fmt.Errorf("ghttp: expected JSON body %s, got %s", body, actual))
+		}
+	}
+}
+
+// VerifyHeader returns a handler that records a failure on the server
+// if the request does not contain every value in h for each header.
+func (s *Server) VerifyHeader(h http.Header) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for key, values := range h {
+			for _, value := range values {
+				found := false
+				for _, actual := range r.Header.Values(key) {
+					if actual == value {
+						found = true
+						break
+					}
+				}
+				if !found {
+					s.fail(fmt.Errorf("ghttp: expected header %q to contain %q, got %v", key, value, r.Header.Values(key)))
+				}
+			}
+		}
+	}
+}
+
+// RespondWith returns a handler that writes status and body as the
+// response. body may be a string or []byte.
+func (s *Server) RespondWith(status int, body interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		switch b := body.(type) {
+		case []byte:
+			w.Write(b)
+		case string:
+			w.Write([]byte(b))
+		case nil:
+		default:
+			s.fail(fmt.Errorf("ghttp: RespondWith body must be a string or []byte, got %T", body))
+		}
+	}
+}
+
+// RespondWithJSONEncoded returns a handler that JSON-encodes v and
+// writes it as the response body with status and a
+// application/json content type.
+func (s *Server) RespondWithJSONEncoded(status int, v interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			s.fail(fmt.Errorf("ghttp: failed to encode JSON response: %s", err))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write(encoded)
+	}
+}
+
+// CombineHandlers returns a handler that invokes each of handlers in
+// order against the same request/response pair, so a single
+// expectation can validate a request and issue its response
+// atomically.
+func CombineHandlers(handlers ...http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, handler := range handlers {
+			handler(w, r)
+		}
+	}
+}
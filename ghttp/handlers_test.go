@@ -0,0 +1,183 @@
+package ghttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestVerifyJSON(t *testing.T) {
+	cases := []struct {
+		name       string
+		expected   string
+		body       string
+		expectFail bool
+	}{
+		{"exact match", `{"a":1,"b":"two"}`, `{"a":1,"b":"two"}`, false},
+		{"different key order is still equal", `{"a":1,"b":"two"}`, `{"b":"two","a":1}`, false},
+		{"different value fails", `{"a":1}`, `{"a":2}`, true},
+		{"extra field fails", `{"a":1}`, `{"a":1,"b":2}`, true},
+		{"invalid request body fails", `{"a":1}`, `not json`, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := NewServer()
+			defer s.Server.Close()
+
+			s.AppendHandlers(s.VerifyJSON(c.expected))
+
+			resp, err := http.Post(s.URL()+"/anything", "application/json", bytes.NewBufferString(c.body))
+			if err != nil {
+				t.Fatalf("POST: %s", err)
+			}
+			resp.Body.Close()
+
+			failed := len(s.Failures()) > 0
+			if failed != c.expectFail {
+				t.Fatalf("expected failure=%v, got failures=%v", c.expectFail, s.Failures())
+			}
+		})
+	}
+}
+
+func TestVerifyHeader(t *testing.T) {
+	cases := []struct {
+		name       string
+		expected   http.Header
+		sent       http.Header
+		expectFail bool
+	}{
+		{
+			"single matching value",
+			http.Header{"X-Token": {"abc"}},
+			http.Header{"X-Token": {"abc"}},
+			false,
+		},
+		{
+			"expected value among several sent",
+			http.Header{"Accept": {"application/json"}},
+			http.Header{"Accept": {"text/plain", "application/json"}},
+			false,
+		},
+		{
+			"missing header fails",
+			http.Header{"X-Token": {"abc"}},
+			http.Header{},
+			true,
+		},
+		{
+			"mismatched value fails",
+			http.Header{"X-Token": {"abc"}},
+			http.Header{"X-Token": {"xyz"}},
+			true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := NewServer()
+			defer s.Server.Close()
+
+			s.AppendHandlers(s.VerifyHeader(c.expected))
+
+			req, err := http.NewRequest(http.MethodGet, s.URL()+"/anything", nil)
+			if err != nil {
+				t.Fatalf("NewRequest: %s", err)
+			}
+			for key, values := range c.sent {
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do: %s", err)
+			}
+			resp.Body.Close()
+
+			failed := len(s.Failures()) > 0
+			if failed != c.expectFail {
+				t.Fatalf("expected failure=%v, got failures=%v", c.expectFail, s.Failures())
+			}
+		})
+	}
+}
+
+func TestRespondWith(t *testing.T) {
+	cases := []struct {
+		name       string
+		status     int
+		body       interface{}
+		wantBody   string
+		expectFail bool
+	}{
+		{"string body", http.StatusOK, "hello", "hello", false},
+		{"byte slice body", http.StatusCreated, []byte("created"), "created", false},
+		{"nil body", http.StatusNoContent, nil, "", false},
+		{"invalid body type records failure", http.StatusOK, 42, "", true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := NewServer()
+			defer s.Server.Close()
+
+			s.AppendHandlers(s.RespondWith(c.status, c.body))
+
+			resp := get(t, s.URL()+"/anything")
+			defer resp.Body.Close()
+
+			if resp.StatusCode != c.status {
+				t.Fatalf("expected status %d, got %d", c.status, resp.StatusCode)
+			}
+
+			got, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("ReadAll: %s", err)
+			}
+			if string(got) != c.wantBody {
+				t.Fatalf("expected body %q, got %q", c.wantBody, got)
+			}
+
+			failed := len(s.Failures()) > 0
+			if failed != c.expectFail {
+				t.Fatalf("expected failure=%v, got failures=%v", c.expectFail, s.Failures())
+			}
+		})
+	}
+}
+
+func TestRespondWithJSONEncoded(t *testing.T) {
+	s := NewServer()
+	defer s.Server.Close()
+
+	payload := struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}{"ada", 36}
+
+	s.AppendHandlers(s.RespondWithJSONEncoded(http.StatusOK, payload))
+
+	resp := get(t, s.URL()+"/anything")
+	defer resp.Body.Close()
+
+	if got := resp.Header.Get("Content-Type"); got != "application/json" {
+		t.Fatalf(`expected Content-Type "application/json", got %q`, got)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil {
+		t.Fatalf("Decode: %s", err)
+	}
+	if decoded["name"] != "ada" || decoded["age"] != float64(36) {
+		t.Fatalf("expected decoded payload to match, got %v", decoded)
+	}
+
+	if len(s.Failures()) != 0 {
+		t.Fatalf("expected no failures, got %v", s.Failures())
+	}
+}
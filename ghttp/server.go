@@ -0,0 +1,123 @@
+package ghttp
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+)
+
+// Server wraps an in-process httptest.Server and a list of ordered
+// expectations registered via AppendHandlers or RouteToHandler.
+//
+// Step definitions register handlers at the start of a scenario and
+// call Close at the end to assert that every appended handler
+// actually received a request. There is no AfterScenario hook that
+// calls Close, and Server does not report through the suite's
+// Formatter, so callers must call Close themselves (e.g. from their
+// own scenario-teardown glue) and handle the returned error the same
+// way they would a failed step.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers []http.HandlerFunc
+	requests []*http.Request
+	routed   map[string]http.HandlerFunc
+	failures []error
+}
+
+// NewServer starts a new in-process HTTP server ready to have
+// handlers appended to it.
+func NewServer() *Server {
+	s := &Server{
+		routed: map[string]http.HandlerFunc{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// URL returns the server's base URL, e.g. http://127.0.0.1:port.
+func (s *Server) URL() string {
+	return s.Server.URL
+}
+
+// AppendHandlers registers handlers to be called in order, one per
+// incoming request. The request fails if more requests arrive than
+// handlers were appended.
+func (s *Server) AppendHandlers(handlers ...http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = append(s.handlers, handlers...)
+}
+
+// RouteToHandler registers handler to serve every request matching
+// method and path, independent of the ordered AppendHandlers queue.
+func (s *Server) RouteToHandler(method, path string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routed[method+" "+path] = handler
+}
+
+// Failures returns the verification failures recorded while serving
+// requests so far, such as an unexpected method/path or an exhausted
+// handler queue.
+func (s *Server) Failures() []error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]error(nil), s.failures...)
+}
+
+// Close shuts down the underlying httptest.Server and returns an
+// error if any appended handler was never consumed, or if any
+// recorded verification failed. Callers should call it once per
+// scenario and treat a non-nil result as a failed step.
+func (s *Server) Close() error {
+	s.Server.Close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if errs := s.failures; len(errs) > 0 {
+		return errs[0]
+	}
+
+	if remaining := len(s.handlers) - len(s.requests); remaining > 0 {
+		return fmt.Errorf("ghttp: %d registered handler(s) were never called", remaining)
+	}
+
+	return nil
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+
+	if handler, ok := s.routed[r.Method+" "+r.URL.Path]; ok {
+		s.mu.Unlock()
+		handler(w, r)
+		return
+	}
+
+	idx := len(s.requests)
+	s.requests = append(s.requests, r)
+
+	if idx >= len(s.handlers) {
+		s.failures = append(s.failures, fmt.Errorf("ghttp: received unexpected request #%d: %s %s", idx+1, r.Method, r.URL.Path))
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	handler := s.handlers[idx]
+	s.mu.Unlock()
+
+	handler(w, r)
+}
+
+// fail records a verification failure against the server so that
+// Close reports it once the scenario ends.
+func (s *Server) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, err)
+}
@@ -0,0 +1,144 @@
+package ghttp
+
+import (
+	"net/http"
+	"testing"
+)
+
+func get(t *testing.T, url string) *http.Response {
+	t.Helper()
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatalf("GET %s: %s", url, err)
+	}
+	return resp
+}
+
+func TestAppendHandlersServedInOrder(t *testing.T) {
+	s := NewServer()
+	defer s.Server.Close()
+
+	var order []string
+	s.AppendHandlers(
+		func(w http.ResponseWriter, r *http.Request) { order = append(order, "first") },
+		func(w http.ResponseWriter, r *http.Request) { order = append(order, "second") },
+	)
+
+	get(t, s.URL()+"/anything")
+	get(t, s.URL()+"/anything")
+
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Fatalf("expected handlers to be served in append order, got %v", order)
+	}
+}
+
+func TestExhaustedHandlerQueueRecordsFailure(t *testing.T) {
+	s := NewServer()
+	defer s.Server.Close()
+
+	s.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {})
+
+	get(t, s.URL()+"/one")
+	resp := get(t, s.URL()+"/two")
+
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected a 500 once handlers are exhausted, got %d", resp.StatusCode)
+	}
+
+	if len(s.Failures()) != 1 {
+		t.Fatalf("expected exactly one recorded failure, got %v", s.Failures())
+	}
+
+	err := s.Close()
+	if err == nil {
+		t.Fatal("expected Close to return the exhausted-queue failure")
+	}
+}
+
+func TestCloseFailsWhenHandlerNeverCalled(t *testing.T) {
+	s := NewServer()
+	defer s.Server.Close()
+
+	s.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {})
+	// Never make a request.
+
+	if err := s.Close(); err == nil {
+		t.Fatal("expected Close to fail when an appended handler was never consumed")
+	}
+}
+
+func TestCloseSucceedsWhenEveryHandlerConsumed(t *testing.T) {
+	s := NewServer()
+	defer s.Server.Close()
+
+	s.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {})
+	get(t, s.URL()+"/one")
+
+	if err := s.Close(); err != nil {
+		t.Fatalf("expected Close to succeed, got %s", err)
+	}
+}
+
+func TestRouteToHandlerTakesPrecedenceOverAppendedQueue(t *testing.T) {
+	s := NewServer()
+	defer s.Server.Close()
+
+	var routedCalled, queuedCalled bool
+	s.RouteToHandler(http.MethodGet, "/special", func(w http.ResponseWriter, r *http.Request) {
+		routedCalled = true
+	})
+	s.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {
+		queuedCalled = true
+	})
+
+	get(t, s.URL()+"/special")
+
+	if !routedCalled {
+		t.Fatal("expected the routed handler to be called for a matching method/path")
+	}
+	if queuedCalled {
+		t.Fatal("expected a routed request not to consume the ordered handler queue")
+	}
+
+	// The appended handler is still waiting for a non-routed request.
+	if err := s.Close(); err == nil {
+		t.Fatal("expected Close to fail since the appended handler was never consumed")
+	}
+}
+
+func TestVerifyRequestRecordsFailureOnMismatch(t *testing.T) {
+	s := NewServer()
+	defer s.Server.Close()
+
+	s.AppendHandlers(s.VerifyRequest(http.MethodPost, "/expected"))
+
+	get(t, s.URL()+"/expected")
+
+	if len(s.Failures()) != 1 {
+		t.Fatalf("expected a method-mismatch failure, got %v", s.Failures())
+	}
+}
+
+func TestCombineHandlersRunsEachHandler(t *testing.T) {
+	var calls []string
+	handler := CombineHandlers(
+		func(w http.ResponseWriter, r *http.Request) { calls = append(calls, "verify") },
+		func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, "respond")
+			w.WriteHeader(http.StatusTeapot)
+		},
+	)
+
+	s := NewServer()
+	defer s.Server.Close()
+	s.AppendHandlers(handler)
+
+	resp := get(t, s.URL()+"/combined")
+
+	if len(calls) != 2 || calls[0] != "verify" || calls[1] != "respond" {
+		t.Fatalf("expected both combined handlers to run in order, got %v", calls)
+	}
+	if resp.StatusCode != http.StatusTeapot {
+		t.Fatalf("expected combined response status, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,67 @@
+package godog
+
+import (
+	"math/rand"
+
+	"github.com/DATA-DOG/godog/gherkin"
+)
+
+// ShuffleByIndex shuffles n items in place using Fisher-Yates driven
+// by a PRNG seeded with seed, and calls swap(i, j) for every
+// transposition it performs. Calling it twice with the same n and
+// seed produces the exact same sequence of swaps, which is what makes
+// a randomized run reproducible via --seed=<n>.
+//
+// Background steps and Before/AfterScenario hooks are untouched by
+// this shuffle: it only reorders which pickle runs in which slot, not
+// what runs around it.
+func ShuffleByIndex(n int, seed int64, swap func(i, j int)) {
+	if n < 2 {
+		return
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	for i := n - 1; i > 0; i-- {
+		j := r.Intn(i + 1)
+		swap(i, j)
+	}
+}
+
+// scenarioRef pairs a scenario with the feature it belongs to, so a
+// flattened, cross-feature ordering can still find its way back to
+// the right background and Node reporting.
+type scenarioRef struct {
+	feature  *gherkin.Feature
+	scenario *gherkin.Scenario
+}
+
+// runAllRandomized runs every scenario across every loaded feature in
+// one combined order shuffled by s.randomizeSeed, instead of the
+// per-feature grouping runFeature uses. Each feature still gets its
+// own background-failure state and its Node is only reported again
+// when the shuffled order switches into a different feature.
+func (s *suite) runAllRandomized() {
+	var refs []scenarioRef
+	for _, f := range s.features {
+		for _, scenario := range f.Scenarios {
+			refs = append(refs, scenarioRef{f, scenario})
+		}
+	}
+
+	ShuffleByIndex(len(refs), s.randomizeSeed, func(i, j int) {
+		refs[i], refs[j] = refs[j], refs[i]
+	})
+
+	failedByFeature := map[*gherkin.Feature]bool{}
+	var lastFeature *gherkin.Feature
+	for _, ref := range refs {
+		if ref.feature != lastFeature {
+			s.fmt.Node(ref.feature)
+			lastFeature = ref.feature
+		}
+
+		failed := failedByFeature[ref.feature]
+		s.runScenario(ref.feature, ref.scenario, &failed)
+		failedByFeature[ref.feature] = failed
+	}
+}
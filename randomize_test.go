@@ -0,0 +1,57 @@
+package godog
+
+import "testing"
+
+func TestShuffleByIndexIsDeterministicGivenSameSeed(t *testing.T) {
+	shuffle := func(seed int64) []int {
+		items := []int{0, 1, 2, 3, 4, 5, 6, 7}
+		ShuffleByIndex(len(items), seed, func(i, j int) {
+			items[i], items[j] = items[j], items[i]
+		})
+		return items
+	}
+
+	first := shuffle(42)
+	second := shuffle(42)
+
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("expected same shuffle for same seed, got %v and %v", first, second)
+		}
+	}
+}
+
+func TestShuffleByIndexDiffersAcrossSeeds(t *testing.T) {
+	shuffle := func(seed int64) []int {
+		items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		ShuffleByIndex(len(items), seed, func(i, j int) {
+			items[i], items[j] = items[j], items[i]
+		})
+		return items
+	}
+
+	a := shuffle(1)
+	b := shuffle(2)
+
+	same := true
+	for i := range a {
+		if a[i] != b[i] {
+			same = false
+			break
+		}
+	}
+
+	if same {
+		t.Fatal("expected different seeds to produce different orderings")
+	}
+}
+
+func TestShuffleByIndexNoopOnSmallInput(t *testing.T) {
+	calls := 0
+	ShuffleByIndex(1, 1, func(i, j int) { calls++ })
+	ShuffleByIndex(0, 1, func(i, j int) { calls++ })
+
+	if calls != 0 {
+		t.Fatalf("expected no swaps for n < 2, got %d", calls)
+	}
+}
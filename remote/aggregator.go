@@ -0,0 +1,254 @@
+package remote
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// Sink is anything that can replay a stream of Events, such as an
+// adapter around an existing godog Formatter. The aggregator decodes
+// events off the wire and replays them through a Sink in feature
+// order so a distributed/parallel run still produces a single,
+// readable report.
+type Sink interface {
+	Feature(feature string, payload []byte)
+	Node(feature string, payload []byte)
+	Passed(feature string, payload []byte)
+	Failed(feature string, payload []byte)
+	Skipped(feature string, payload []byte)
+	Pending(feature string, payload []byte)
+	Undefined(feature string, payload []byte)
+	Summary(payload []byte)
+}
+
+// Aggregator accepts connections from `shards` godog processes, each
+// streaming Events produced by the remote formatter (see Client), and
+// re-emits them through a Sink once every shard has reported done or
+// a shard is declared crashed.
+//
+// Connections are tracked by accept-order slot rather than by shard
+// name, since the aggregator has no way to know which shard a
+// connection belongs to until its first Event arrives. A slot is
+// declared crashed if: it never connects within heartbeatTimeout of
+// Run starting; it connects but never sends a single event (so its
+// shard name is never learned) within heartbeatTimeout; or it sends
+// events and then goes heartbeatTimeout without sending another one
+// (including a clean EventShardDone) - any of these cause Run to
+// return the crashed slots/shard names and a non-nil error rather
+// than waiting forever.
+type Aggregator struct {
+	listener         net.Listener
+	shards           int
+	heartbeatTimeout time.Duration
+
+	mu        sync.Mutex
+	accepted  int
+	slotSeen  map[int]time.Time
+	slotShard map[int]string
+	conns     []net.Conn
+	done      map[string]bool
+	features  []string
+	byFeature map[string][]Event
+}
+
+// NewAggregator returns an Aggregator that expects exactly `shards`
+// distinct shard connections on listener, treating a shard as crashed
+// if it goes heartbeatTimeout without sending any event.
+func NewAggregator(listener net.Listener, shards int, heartbeatTimeout time.Duration) *Aggregator {
+	return &Aggregator{
+		listener:         listener,
+		shards:           shards,
+		heartbeatTimeout: heartbeatTimeout,
+		slotSeen:         map[int]time.Time{},
+		slotShard:        map[int]string{},
+		done:             map[string]bool{},
+		byFeature:        map[string][]Event{},
+	}
+}
+
+// Run accepts connections until every expected shard has sent a
+// clean EventShardDone, or until a slot is declared crashed (see the
+// Aggregator doc comment). Either way it then replays whatever was
+// collected through sink and returns. A non-empty shard list means
+// the caller should exit non-zero rather than treat the run as
+// successful.
+//
+// Run takes ownership of listener for the duration of the call:
+// once it returns, it closes both the listener and every connection
+// it accepted, so the acceptLoop/readConn goroutines it started never
+// block forever trying to hand off an event nobody is reading.
+func (a *Aggregator) Run(sink Sink) ([]string, error) {
+	events := make(chan Event)
+	acceptErrs := make(chan error, 1)
+	done := make(chan struct{})
+	start := time.Now()
+
+	go a.acceptLoop(events, acceptErrs, done)
+
+	ticker := time.NewTicker(a.heartbeatTimeout / 2)
+	defer ticker.Stop()
+
+	finish := func(crashed []string, err error) ([]string, error) {
+		close(done)
+		a.listener.Close()
+		a.closeConns()
+		a.replay(sink)
+		return crashed, err
+	}
+
+	for {
+		select {
+		case ev := <-events:
+			a.record(ev)
+			if ev.Kind == EventShardDone {
+				a.mu.Lock()
+				a.done[ev.Shard] = true
+				finished := len(a.done) >= a.shards && a.accepted >= a.shards
+				a.mu.Unlock()
+				if finished {
+					return finish(nil, nil)
+				}
+			}
+		case err := <-acceptErrs:
+			return finish(a.crashedShards(start), err)
+		case <-ticker.C:
+			if crashed := a.crashedShards(start); len(crashed) > 0 {
+				return finish(crashed, fmt.Errorf("remote: shard(s) %v stopped responding", crashed))
+			}
+		}
+	}
+}
+
+func (a *Aggregator) acceptLoop(events chan<- Event, errs chan<- error, done <-chan struct{}) {
+	for slot := 0; slot < a.shards; slot++ {
+		conn, err := a.listener.Accept()
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		a.mu.Lock()
+		a.accepted++
+		a.slotSeen[slot] = time.Now()
+		a.conns = append(a.conns, conn)
+		a.mu.Unlock()
+
+		go a.readConn(slot, conn, events, done)
+	}
+}
+
+func (a *Aggregator) readConn(slot int, conn net.Conn, events chan<- Event, done <-chan struct{}) {
+	dec := NewDecoder(conn)
+	for {
+		ev, err := dec.Decode()
+		if err != nil {
+			// The connection closed or broke without a clean
+			// EventShardDone. Don't synthesize one: the slot's
+			// lastSeen simply stops advancing, so crashedShards
+			// will flag it once heartbeatTimeout elapses.
+			return
+		}
+
+		a.mu.Lock()
+		a.slotSeen[slot] = time.Now()
+		a.slotShard[slot] = ev.Shard
+		a.mu.Unlock()
+
+		select {
+		case events <- ev:
+		case <-done:
+			return
+		}
+	}
+}
+
+func (a *Aggregator) closeConns() {
+	a.mu.Lock()
+	conns := append([]net.Conn(nil), a.conns...)
+	a.mu.Unlock()
+
+	for _, conn := range conns {
+		conn.Close()
+	}
+}
+
+func (a *Aggregator) record(ev Event) {
+	if ev.Kind == EventHeartbeat {
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := ev.Feature
+	if _, ok := a.byFeature[key]; !ok {
+		a.features = append(a.features, key)
+	}
+	a.byFeature[key] = append(a.byFeature[key], ev)
+}
+
+// crashedShards returns every slot known to have gone silent for
+// longer than heartbeatTimeout - whether it never connected at all,
+// connected but never sent a single event, or sent events and then
+// stopped - once heartbeatTimeout has elapsed since start. A slot
+// whose shard name is still unknown (no event ever arrived) is
+// reported as "silent-shard-slot-N"; one that never connected at all
+// is reported as "unconnected-shard-slot-N".
+func (a *Aggregator) crashedShards(start time.Time) []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var crashed []string
+	for slot := 0; slot < a.shards; slot++ {
+		seen, accepted := a.slotSeen[slot]
+		if !accepted {
+			if time.Since(start) > a.heartbeatTimeout {
+				crashed = append(crashed, fmt.Sprintf("unconnected-shard-slot-%d", slot+1))
+			}
+			continue
+		}
+
+		name := a.slotShard[slot]
+		if name != "" && a.done[name] {
+			continue
+		}
+		if time.Since(seen) > a.heartbeatTimeout {
+			if name == "" {
+				name = fmt.Sprintf("silent-shard-slot-%d", slot+1)
+			}
+			crashed = append(crashed, name)
+		}
+	}
+
+	return crashed
+}
+
+func (a *Aggregator) replay(sink Sink) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, feature := range a.features {
+		for _, ev := range a.byFeature[feature] {
+			switch ev.Kind {
+			case EventFeature:
+				sink.Feature(ev.Feature, ev.Payload)
+			case EventNode:
+				sink.Node(ev.Feature, ev.Payload)
+			case EventPassed:
+				sink.Passed(ev.Feature, ev.Payload)
+			case EventFailed:
+				sink.Failed(ev.Feature, ev.Payload)
+			case EventSkipped:
+				sink.Skipped(ev.Feature, ev.Payload)
+			case EventPending:
+				sink.Pending(ev.Feature, ev.Payload)
+			case EventUndefined:
+				sink.Undefined(ev.Feature, ev.Payload)
+			case EventSummary:
+				sink.Summary(ev.Payload)
+			}
+		}
+	}
+}
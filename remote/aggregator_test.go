@@ -0,0 +1,216 @@
+package remote
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+const testHeartbeatTimeout = 150 * time.Millisecond
+
+func listen(t *testing.T) net.Listener {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %s", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return l
+}
+
+type fakeSink struct {
+	calls []string
+}
+
+func (s *fakeSink) Feature(feature string, payload []byte) {
+	s.calls = append(s.calls, "feature:"+feature)
+}
+func (s *fakeSink) Node(feature string, payload []byte) { s.calls = append(s.calls, "node:"+feature) }
+func (s *fakeSink) Passed(feature string, payload []byte) {
+	s.calls = append(s.calls, "passed:"+feature)
+}
+func (s *fakeSink) Failed(feature string, payload []byte) {
+	s.calls = append(s.calls, "failed:"+feature)
+}
+func (s *fakeSink) Skipped(feature string, payload []byte) {
+	s.calls = append(s.calls, "skipped:"+feature)
+}
+func (s *fakeSink) Pending(feature string, payload []byte) {
+	s.calls = append(s.calls, "pending:"+feature)
+}
+func (s *fakeSink) Undefined(feature string, payload []byte) {
+	s.calls = append(s.calls, "undefined:"+feature)
+}
+func (s *fakeSink) Summary(payload []byte) { s.calls = append(s.calls, "summary") }
+
+func TestAggregatorRunSucceedsWhenAllShardsReportDone(t *testing.T) {
+	l := listen(t)
+	agg := NewAggregator(l, 2, testHeartbeatTimeout)
+
+	for _, shard := range []string{"shard-a", "shard-b"} {
+		client, err := Dial("tcp", l.Addr().String(), shard)
+		if err != nil {
+			t.Fatalf("dial: %s", err)
+		}
+		if err := client.Feature("login.feature", nil); err != nil {
+			t.Fatalf("feature: %s", err)
+		}
+		if err := client.Passed("login.feature", nil); err != nil {
+			t.Fatalf("passed: %s", err)
+		}
+		if err := client.Close(); err != nil {
+			t.Fatalf("close: %s", err)
+		}
+	}
+
+	sink := &fakeSink{}
+	crashed, err := agg.Run(sink)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+	if len(crashed) != 0 {
+		t.Fatalf("expected no crashed shards, got %v", crashed)
+	}
+	if len(sink.calls) != 4 {
+		t.Fatalf("expected 4 replayed calls, got %v", sink.calls)
+	}
+}
+
+func TestAggregatorRunDetectsShardThatNeverConnects(t *testing.T) {
+	l := listen(t)
+	agg := NewAggregator(l, 2, testHeartbeatTimeout)
+
+	client, err := Dial("tcp", l.Addr().String(), "shard-a")
+	if err != nil {
+		t.Fatalf("dial: %s", err)
+	}
+	if err := client.Feature("login.feature", nil); err != nil {
+		t.Fatalf("feature: %s", err)
+	}
+	if err := client.Close(); err != nil {
+		t.Fatalf("close: %s", err)
+	}
+	// The second expected shard never dials in.
+
+	done := make(chan struct{})
+	var crashed []string
+	var runErr error
+	go func() {
+		crashed, runErr = agg.Run(&fakeSink{})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run hung instead of detecting the shard that never connected")
+	}
+
+	if runErr == nil {
+		t.Fatal("expected an error when a shard never connects")
+	}
+	if len(crashed) == 0 {
+		t.Fatal("expected at least one crashed shard to be reported")
+	}
+}
+
+func TestAggregatorRunDetectsShardThatConnectsButNeverSends(t *testing.T) {
+	l := listen(t)
+	agg := NewAggregator(l, 2, testHeartbeatTimeout)
+
+	healthy, err := Dial("tcp", l.Addr().String(), "shard-healthy")
+	if err != nil {
+		t.Fatalf("dial: %s", err)
+	}
+
+	// A raw connect with no Event ever sent: accepted, but its shard
+	// name is never learned.
+	silent, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %s", err)
+	}
+	defer silent.Close()
+
+	done := make(chan struct{})
+	var crashed []string
+	var runErr error
+	go func() {
+		crashed, runErr = agg.Run(&fakeSink{})
+		close(done)
+	}()
+
+	time.Sleep(testHeartbeatTimeout / 3)
+	healthy.Heartbeat()
+	healthy.Close()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run hung instead of detecting the shard that connected but never sent anything")
+	}
+
+	if runErr == nil {
+		t.Fatal("expected an error when an accepted shard never sends an event")
+	}
+	if len(crashed) == 0 {
+		t.Fatal("expected the silent shard slot to be reported crashed")
+	}
+}
+
+func TestAggregatorRunDetectsShardThatDropsMidStream(t *testing.T) {
+	l := listen(t)
+	agg := NewAggregator(l, 2, testHeartbeatTimeout)
+
+	healthy, err := Dial("tcp", l.Addr().String(), "shard-healthy")
+	if err != nil {
+		t.Fatalf("dial: %s", err)
+	}
+
+	flaky, err := Dial("tcp", l.Addr().String(), "shard-flaky")
+	if err != nil {
+		t.Fatalf("dial: %s", err)
+	}
+	if err := flaky.Feature("login.feature", nil); err != nil {
+		t.Fatalf("feature: %s", err)
+	}
+	// Drop the connection instead of sending EventShardDone.
+	if err := flaky.conn.Close(); err != nil {
+		t.Fatalf("close flaky conn: %s", err)
+	}
+
+	done := make(chan struct{})
+	var crashed []string
+	var runErr error
+	go func() {
+		crashed, runErr = agg.Run(&fakeSink{})
+		close(done)
+	}()
+
+	// Keep the healthy shard alive for a bit, proving that another
+	// shard's activity does not mask the flaky one going silent.
+	time.Sleep(testHeartbeatTimeout / 3)
+	healthy.Heartbeat()
+	time.Sleep(testHeartbeatTimeout)
+	healthy.Close()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run hung instead of detecting the shard that dropped mid-stream")
+	}
+
+	if runErr == nil {
+		t.Fatal("expected an error when a shard drops mid-stream")
+	}
+
+	found := false
+	for _, shard := range crashed {
+		if shard == "shard-flaky" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected shard-flaky to be reported crashed, got %v", crashed)
+	}
+}
@@ -0,0 +1,116 @@
+package remote
+
+import (
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+)
+
+// Client streams a single shard's Feature/Node/Passed/.../Summary
+// calls to an Aggregator over a TCP or Unix socket connection,
+// serializing each call as an Event. Its methods mirror the Formatter
+// calls one for one; this package does not itself implement a
+// Formatter that forwards to them.
+type Client struct {
+	shard string
+	conn  net.Conn
+	enc   *Encoder
+
+	mu  sync.Mutex
+	seq int
+}
+
+// Dial connects to the aggregator listening on network/address (e.g.
+// "tcp", "localhost:7070") and identifies this connection as shard.
+func Dial(network, address, shard string) (*Client, error) {
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		shard: shard,
+		conn:  conn,
+		enc:   NewEncoder(conn),
+	}, nil
+}
+
+// Close sends a final EventShardDone and closes the connection so the
+// aggregator can tell a clean shutdown apart from a crashed shard.
+func (c *Client) Close() error {
+	c.send(EventShardDone, "", nil)
+	return c.conn.Close()
+}
+
+// Heartbeat sends a liveness ping so the aggregator's crash-detection
+// has something to reset against during long-running steps.
+func (c *Client) Heartbeat() error {
+	return c.send(EventHeartbeat, "", nil)
+}
+
+// Feature streams an EventFeature call for feature, carrying payload
+// as the marshaled arguments the local formatter would have received.
+func (c *Client) Feature(feature string, payload interface{}) error {
+	return c.send(EventFeature, feature, payload)
+}
+
+// Node streams an EventNode call.
+func (c *Client) Node(feature string, payload interface{}) error {
+	return c.send(EventNode, feature, payload)
+}
+
+// Passed streams an EventPassed call.
+func (c *Client) Passed(feature string, payload interface{}) error {
+	return c.send(EventPassed, feature, payload)
+}
+
+// Failed streams an EventFailed call.
+func (c *Client) Failed(feature string, payload interface{}) error {
+	return c.send(EventFailed, feature, payload)
+}
+
+// Skipped streams an EventSkipped call.
+func (c *Client) Skipped(feature string, payload interface{}) error {
+	return c.send(EventSkipped, feature, payload)
+}
+
+// Pending streams an EventPending call.
+func (c *Client) Pending(feature string, payload interface{}) error {
+	return c.send(EventPending, feature, payload)
+}
+
+// Undefined streams an EventUndefined call.
+func (c *Client) Undefined(feature string, payload interface{}) error {
+	return c.send(EventUndefined, feature, payload)
+}
+
+// Summary streams the final EventSummary call once the shard has run
+// every feature it was assigned.
+func (c *Client) Summary(payload interface{}) error {
+	return c.send(EventSummary, "", payload)
+}
+
+func (c *Client) send(kind EventKind, feature string, payload interface{}) error {
+	var raw json.RawMessage
+	if payload != nil {
+		encoded, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		raw = encoded
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.seq++
+	c.conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	return c.enc.Encode(Event{
+		Shard:   c.shard,
+		Seq:     c.seq,
+		Kind:    kind,
+		Feature: feature,
+		Payload: raw,
+	})
+}
@@ -0,0 +1,20 @@
+// Package remote implements the wire protocol and aggregator that a
+// future godog "remote" formatter and `godog aggregate
+// --listen=addr --shards=N --format=pretty` command would sit on top
+// of, inspired by ginkgo's parallel-node aggregator: a client/server
+// pair that lets multiple godog processes (potentially across
+// machines/CI shards) stream scenario events to a coordinator which
+// produces a single unified report.
+//
+// This package registers no "remote" formatter and provides no
+// aggregate command or other CLI entry point; it only provides the
+// protocol and
+// aggregation logic they would be built on: a Client serializes each
+// Feature/Node/Passed/Failed/Skipped/Pending/Undefined/Summary call
+// as a newline-delimited JSON Event and sends it to an Aggregator,
+// which decodes events from every shard, orders them per feature,
+// and replays them through a Sink wrapping any existing formatter. A
+// heartbeat and shard-completion protocol lets the Aggregator detect
+// a shard that never connects, or one that goes silent mid-stream,
+// and return it so the caller can exit non-zero instead of hanging.
+package remote
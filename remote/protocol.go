@@ -0,0 +1,83 @@
+package remote
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+)
+
+// EventKind identifies which Formatter call produced an Event, so an
+// aggregator can replay the exact same sequence of calls against any
+// existing formatter.
+type EventKind string
+
+// The event kinds mirror godog's Formatter interface: one per call
+// a shard's formatter would otherwise have received locally.
+const (
+	EventFeature   EventKind = "feature"
+	EventNode      EventKind = "node"
+	EventPassed    EventKind = "passed"
+	EventFailed    EventKind = "failed"
+	EventSkipped   EventKind = "skipped"
+	EventPending   EventKind = "pending"
+	EventUndefined EventKind = "undefined"
+	EventSummary   EventKind = "summary"
+	EventHeartbeat EventKind = "heartbeat"
+	EventShardDone EventKind = "shard-done"
+)
+
+// Event is one newline-delimited-JSON record streamed from a shard to
+// the aggregator. Payload carries whatever arguments the mirrored
+// Formatter call received, left as opaque JSON since the aggregator
+// only needs to decode it enough to re-emit in feature order.
+type Event struct {
+	Shard   string          `json:"shard"`
+	Seq     int             `json:"seq"`
+	Kind    EventKind       `json:"kind"`
+	Feature string          `json:"feature,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// Encoder writes Events as newline-delimited JSON to an underlying
+// connection.
+type Encoder struct {
+	enc *json.Encoder
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{enc: json.NewEncoder(w)}
+}
+
+// Encode writes ev followed by a newline.
+func (e *Encoder) Encode(ev Event) error {
+	return e.enc.Encode(ev)
+}
+
+// Decoder reads Events streamed as newline-delimited JSON from an
+// underlying connection.
+type Decoder struct {
+	scanner *bufio.Scanner
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	return &Decoder{scanner: scanner}
+}
+
+// Decode reads the next Event, returning io.EOF once the stream is
+// exhausted.
+func (d *Decoder) Decode() (Event, error) {
+	if !d.scanner.Scan() {
+		if err := d.scanner.Err(); err != nil {
+			return Event{}, err
+		}
+		return Event{}, io.EOF
+	}
+
+	var ev Event
+	err := json.Unmarshal(d.scanner.Bytes(), &ev)
+	return ev, err
+}
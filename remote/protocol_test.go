@@ -0,0 +1,39 @@
+package remote
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestEncoderDecoderRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+
+	want := []Event{
+		{Shard: "shard-1", Seq: 1, Kind: EventFeature, Feature: "login.feature"},
+		{Shard: "shard-1", Seq: 2, Kind: EventPassed, Feature: "login.feature", Payload: []byte(`{"step":"I log in"}`)},
+		{Shard: "shard-1", Seq: 3, Kind: EventShardDone},
+	}
+
+	for _, ev := range want {
+		if err := enc.Encode(ev); err != nil {
+			t.Fatalf("encode: %s", err)
+		}
+	}
+
+	dec := NewDecoder(&buf)
+	for i, expected := range want {
+		got, err := dec.Decode()
+		if err != nil {
+			t.Fatalf("decode event %d: %s", i, err)
+		}
+		if got.Shard != expected.Shard || got.Seq != expected.Seq || got.Kind != expected.Kind || got.Feature != expected.Feature {
+			t.Fatalf("event %d: expected %+v, got %+v", i, expected, got)
+		}
+	}
+
+	if _, err := dec.Decode(); err != io.EOF {
+		t.Fatalf("expected io.EOF after last event, got %v", err)
+	}
+}
@@ -3,9 +3,11 @@ package godog
 import (
 	"flag"
 	"fmt"
+	"os"
 	"regexp"
 	"strconv"
 
+	"github.com/DATA-DOG/godog/bench"
 	"github.com/DATA-DOG/godog/gherkin"
 )
 
@@ -81,6 +83,31 @@ type suite struct {
 	steps    []*stepMatchHandler
 	features []*gherkin.Feature
 	fmt      Formatter
+
+	// focused is set once per Run if any loaded scenario carries
+	// FocusTag, putting the whole run into focus mode: only focused
+	// scenarios execute and everything else is reported skipped.
+	focused       bool
+	failOnFocused bool
+
+	// pendingEncountered is set once per Run if any step was reported
+	// pending, whether from PendingTag or from having no matching
+	// handler, for FailOnPending to act on.
+	pendingEncountered bool
+	failOnPending      bool
+
+	// randomize enables shuffling scenario order with randomizeSeed
+	// before the run, set via Randomize/RandomizeAll. randomizeAll
+	// additionally shuffles scenarios across every feature into one
+	// combined order, instead of reordering each feature on its own.
+	randomize     bool
+	randomizeAll  bool
+	randomizeSeed int64
+
+	// benchmarkSamples is the number of times a BenchmarkTag-ed
+	// scenario runs, set via Benchmark. 0 disables benchmark mode.
+	benchmarkSamples int
+	benchReport      bench.Report
 }
 
 // New initializes a suite which supports the Suite
@@ -119,12 +146,108 @@ func (s *suite) Run() {
 	s.features, err = cfg.features()
 	fatal(err)
 
+	s.focused = HasFocusedScenarios(s.scenarioTagSets())
+
 	fmt.Println("running", cl("godog", cyan)+", num registered steps:", cl(len(s.steps), yellow))
 	fmt.Println("have loaded", cl(len(s.features), yellow), "features from path:", cl(cfg.featuresPath, green))
+	if s.focused {
+		fmt.Println(cl("running in focus mode: only @focus scenarios will execute", yellow))
+	}
+	if s.randomize {
+		fmt.Println("randomized with", cl("--seed", cyan), cl(s.randomizeSeed, yellow))
+		if !s.randomizeAll {
+			for _, f := range s.features {
+				scenarios := f.Scenarios
+				ShuffleByIndex(len(scenarios), s.randomizeSeed, func(i, j int) {
+					scenarios[i], scenarios[j] = scenarios[j], scenarios[i]
+				})
+			}
+		}
+	}
+
+	if s.randomize && s.randomizeAll {
+		s.runAllRandomized()
+	} else {
+		for _, f := range s.features {
+			s.runFeature(f)
+		}
+	}
+
+	if s.benchmarkSamples > 0 {
+		bench.WriteText(os.Stdout, s.benchReport)
+	}
 
+	if FocusModeFailsRun(s.focused, s.failOnFocused) {
+		fatal(fmt.Errorf("godog: run executed under focus mode with FailOnFocused enabled"))
+	}
+	if PendingModeFailsRun(s.pendingEncountered, s.failOnPending) {
+		fatal(fmt.Errorf("godog: run encountered a pending step with FailOnPending enabled"))
+	}
+}
+
+// FailOnFocused configures whether Run should fail the whole process
+// (via fatal, like an option-validation error) when any scenario ran
+// under focus mode, mirroring ginkgo's --fail-on-focused. It is off
+// by default so an accidental @focus tag still runs the rest of the
+// suite without breaking CI on its own.
+func (s *suite) FailOnFocused(v bool) {
+	s.failOnFocused = v
+}
+
+// FailOnPending configures whether Run should fail the whole process
+// (via fatal) when any step was reported pending during the run,
+// mirroring cucumber's --strict. It is off by default, so pending
+// steps are reported without breaking CI on their own, alongside the
+// existing behavior where a pending step still fails its own scenario
+// and skips the steps after it.
+func (s *suite) FailOnPending(v bool) {
+	s.failOnPending = v
+}
+
+// Randomize enables shuffling the scenario order of every feature
+// before it runs, seeded with seed so the order can be reproduced by
+// passing the same seed again. It is off by default, since the
+// suite's declaration order is what most step definitions are
+// written and debugged against.
+func (s *suite) Randomize(seed int64) {
+	s.randomize = true
+	s.randomizeSeed = seed
+}
+
+// RandomizeAll is like Randomize, but shuffles scenarios across every
+// loaded feature into a single combined running order instead of
+// reordering each feature independently.
+func (s *suite) RandomizeAll(seed int64) {
+	s.randomize = true
+	s.randomizeAll = true
+	s.randomizeSeed = seed
+}
+
+// RandomizeSeed returns the seed passed to Randomize/RandomizeAll, so
+// a caller can embed it in their own report metadata. This codebase
+// has no JUnit/cucumber formatter of its own to write the seed into,
+// so surfacing it here is as far as the wiring goes.
+func (s *suite) RandomizeSeed() int64 {
+	return s.randomizeSeed
+}
+
+// scenarioTagSets returns the combined feature+scenario tags for
+// every scenario across every loaded feature, in the shape
+// HasFocusedScenarios expects.
+func (s *suite) scenarioTagSets() [][]string {
+	var sets [][]string
 	for _, f := range s.features {
-		s.runFeature(f)
+		for _, scenario := range f.Scenarios {
+			sets = append(sets, scenarioTags(f, scenario))
+		}
 	}
+	return sets
+}
+
+// scenarioTags returns the tags that apply to scenario, including
+// the ones inherited from its parent feature.
+func scenarioTags(f *gherkin.Feature, scenario *gherkin.Scenario) []string {
+	return append(tagNames(f.Tags), tagNames(scenario.Tags)...)
 }
 
 func (s *suite) runStep(step *gherkin.Step) (err error) {
@@ -141,6 +264,7 @@ func (s *suite) runStep(step *gherkin.Step) (err error) {
 	}
 	if match == nil {
 		s.fmt.Pending(step)
+		s.pendingEncountered = true
 		return errPending
 	}
 
@@ -183,19 +307,51 @@ func (s *suite) runFeature(f *gherkin.Feature) {
 	s.fmt.Node(f)
 	var failed bool
 	for _, scenario := range f.Scenarios {
-		// background
-		// @TODO: do not print more than once
-		if f.Background != nil && !failed {
-			s.fmt.Node(f.Background)
-			failed = s.runSteps(f.Background.Steps)
-		}
+		s.runScenario(f, scenario, &failed)
+	}
+}
+
+// runScenario runs a single scenario of f, dispatching it to the
+// focus-skip, benchmark, pending, or normal background+steps path.
+// failed tracks whether a prior scenario of f left the feature's
+// background in a failed state, and is updated in place so callers
+// iterating multiple scenarios of the same feature share that state.
+func (s *suite) runScenario(f *gherkin.Feature, scenario *gherkin.Scenario, failed *bool) {
+	tags := scenarioTags(f, scenario)
 
-		// scenario
+	// a @focus scenario anywhere in the run puts everything that
+	// isn't itself focused into skipped, background included.
+	if s.focused && !IsFocused(tags) {
 		s.fmt.Node(scenario)
-		if failed {
-			s.skipSteps(scenario.Steps)
-		} else {
-			s.runSteps(scenario.Steps)
+		s.skipSteps(scenario.Steps)
+		return
+	}
+
+	if s.benchmarkSamples > 0 && IsBenchmarked(tags) {
+		s.runBenchmarkedScenario(f, scenario)
+		return
+	}
+
+	// background
+	// @TODO: do not print more than once
+	if f.Background != nil && !*failed {
+		s.fmt.Node(f.Background)
+		*failed = s.runSteps(f.Background.Steps)
+	}
+
+	// scenario
+	s.fmt.Node(scenario)
+	switch {
+	case IsPending(tags):
+		// @pending always reports pending, regardless of whether
+		// a step definition would otherwise match.
+		s.pendingEncountered = true
+		for _, step := range scenario.Steps {
+			s.fmt.Pending(step)
 		}
+	case *failed:
+		s.skipSteps(scenario.Steps)
+	default:
+		s.runSteps(scenario.Steps)
 	}
 }
\ No newline at end of file